@@ -0,0 +1,301 @@
+package extractor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+// ChannelInfo describes a channel created with make(chan ...) inside a
+// function body.
+type ChannelInfo struct {
+	Name      string
+	ElemType  string
+	Buffer    string // raw expression text, e.g. "BufferSize" or "len(users)"
+	Direction string // "send", "recv", or "bidi"
+}
+
+// MutexUse records a lock/unlock call on a field of mutex-ish type
+// (sync.Mutex, sync.RWMutex), in program order, so "held on entry/exit"
+// can be read straight off the list without re-walking the body.
+type MutexUse struct {
+	Field string
+	Op    string // "Lock", "Unlock", "RLock", "RUnlock"
+}
+
+// Goroutine is one `go` statement inside a function.
+type Goroutine struct {
+	ID       string
+	Sends    []string // channel names this goroutine sends on
+	Receives []string // channel names this goroutine receives on
+	Closes   []string // channel names this goroutine closes
+}
+
+// ExtractConcurrency walks file and, for every function with at least one
+// concurrency construct, emits a Concurrency summary node plus spawns
+// edges to each goroutine and sends_on/receives_on/closes edges from each
+// goroutine to the channels it touches.
+func ExtractConcurrency(fset *token.FileSet, file *ast.File) ([]Node, []Edge) {
+	var nodes []Node
+	var edges []Edge
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		channels := map[string]*ChannelInfo{}
+		var mutexes []MutexUse
+		contextParams := contextParamNames(fn)
+		usedContextParams := map[string]bool{}
+		hasRecover := false
+		var goroutines []Goroutine
+
+		// Top-level channel creation and mutex use (outside any `go`
+		// statement) belong to the function itself.
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.GoStmt:
+				g := extractGoroutine(fset, fn.Name.Name, len(goroutines), v, channels, contextParams, usedContextParams)
+				goroutines = append(goroutines, g)
+				return false // the goroutine's own body is walked separately
+			case *ast.AssignStmt:
+				recordChannelDecl(v, channels)
+			case *ast.CallExpr:
+				if op, field, ok := mutexCall(v); ok {
+					mutexes = append(mutexes, MutexUse{Field: field, Op: op})
+				}
+			case *ast.UnaryExpr:
+				if v.Op == token.ARROW {
+					if name, ok := contextDoneOrErrTarget(v.X); ok && contextParams[name] {
+						usedContextParams[name] = true
+					}
+				}
+			case *ast.DeferStmt:
+				if isRecoverFuncLit(v.Call) {
+					hasRecover = true
+				}
+			}
+			return true
+		})
+
+		if len(goroutines) == 0 && len(channels) == 0 && len(mutexes) == 0 && !hasRecover && len(usedContextParams) == 0 {
+			continue
+		}
+
+		var chanList []ChannelInfo
+		for _, c := range channels {
+			chanList = append(chanList, *c)
+		}
+		var ctxList []string
+		for name := range usedContextParams {
+			ctxList = append(ctxList, name)
+		}
+
+		nodeID := fmt.Sprintf("%s#concurrency", fn.Name.Name)
+		nodes = append(nodes, Node{
+			ID:   nodeID,
+			Kind: NodeConcurrency,
+			Attrs: map[string]any{
+				"func":            fn.Name.Name,
+				"goroutine_count": len(goroutines),
+				"channels":        chanList,
+				"mutexes":         mutexes,
+				"context_params":  ctxList,
+				"has_recover":     hasRecover,
+			},
+		})
+
+		for _, g := range goroutines {
+			edges = append(edges, Edge{From: fn.Name.Name, To: g.ID, Kind: EdgeSpawns})
+			for _, ch := range g.Sends {
+				edges = append(edges, Edge{From: g.ID, To: ch, Kind: EdgeSendsOn})
+			}
+			for _, ch := range g.Receives {
+				edges = append(edges, Edge{From: g.ID, To: ch, Kind: EdgeReceivesOn})
+			}
+			for _, ch := range g.Closes {
+				edges = append(edges, Edge{From: g.ID, To: ch, Kind: EdgeCloses})
+			}
+		}
+	}
+
+	return nodes, edges
+}
+
+// extractGoroutine walks a single `go func() { ... }()` (or `go f(...)`)
+// statement's body, recording which channels it sends to, receives from,
+// and closes. Any channel it declares via make(...) is folded into the
+// enclosing function's channel map since ownership is tracked per func,
+// not per goroutine.
+func extractGoroutine(fset *token.FileSet, funcName string, index int, stmt *ast.GoStmt, channels map[string]*ChannelInfo, contextParams, usedContextParams map[string]bool) Goroutine {
+	pos := fset.Position(stmt.Pos())
+	g := Goroutine{ID: fmt.Sprintf("%s.goroutine#%d@%d", funcName, index, pos.Line)}
+
+	lit, ok := stmt.Call.Fun.(*ast.FuncLit)
+	if !ok {
+		return g
+	}
+
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.SendStmt:
+			// Covers both a bare `ch <- x` and a select statement's
+			// send case, since CommClause.Comm is visited as a normal
+			// child node.
+			if name, ok := chanName(v.Chan); ok {
+				g.Sends = append(g.Sends, name)
+			}
+		case *ast.UnaryExpr:
+			if v.Op == token.ARROW {
+				if name, ok := chanName(v.X); ok {
+					g.Receives = append(g.Receives, name)
+				}
+				if name, ok := contextDoneOrErrTarget(v.X); ok && contextParams[name] {
+					usedContextParams[name] = true
+				}
+			}
+		case *ast.CallExpr:
+			if name, ok := closeCallTarget(v); ok {
+				g.Closes = append(g.Closes, name)
+			}
+		case *ast.AssignStmt:
+			recordChannelDecl(v, channels)
+		}
+		return true
+	})
+	return g
+}
+
+// recordChannelDecl captures `name := make(chan Elem, buf)` assignments.
+func recordChannelDecl(assign *ast.AssignStmt, channels map[string]*ChannelInfo) {
+	if len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return
+	}
+	ident, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != "make" || len(call.Args) == 0 {
+		return
+	}
+	chanType, ok := call.Args[0].(*ast.ChanType)
+	if !ok {
+		return
+	}
+	info := &ChannelInfo{Name: ident.Name, ElemType: exprString(chanType.Value)}
+	switch chanType.Dir {
+	case ast.SEND:
+		info.Direction = "send"
+	case ast.RECV:
+		info.Direction = "recv"
+	default:
+		info.Direction = "bidi"
+	}
+	if len(call.Args) > 1 {
+		info.Buffer = exprString(call.Args[1])
+	} else {
+		info.Buffer = "0"
+	}
+	channels[ident.Name] = info
+}
+
+// chanName returns the identifier name if e refers to a bare channel
+// variable (the only shape our channel map tracks).
+func chanName(e ast.Expr) (string, bool) {
+	if id, ok := e.(*ast.Ident); ok {
+		return id.Name, true
+	}
+	return "", false
+}
+
+// mutexCall recognizes s.field.Lock()/Unlock()/RLock()/RUnlock() calls.
+func mutexCall(call *ast.CallExpr) (op, field string, ok bool) {
+	sel, isSel := call.Fun.(*ast.SelectorExpr)
+	if !isSel {
+		return "", "", false
+	}
+	switch sel.Sel.Name {
+	case "Lock", "Unlock", "RLock", "RUnlock":
+	default:
+		return "", "", false
+	}
+	fieldSel, isSel := sel.X.(*ast.SelectorExpr)
+	if !isSel {
+		return "", "", false
+	}
+	return sel.Sel.Name, fieldSel.Sel.Name, true
+}
+
+// closeCallTarget recognizes close(ch).
+func closeCallTarget(call *ast.CallExpr) (string, bool) {
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != "close" || len(call.Args) != 1 {
+		return "", false
+	}
+	return chanName(call.Args[0])
+}
+
+// isRecoverFuncLit recognizes `defer func() { ... recover() ... }()`.
+func isRecoverFuncLit(call *ast.CallExpr) bool {
+	lit, ok := call.Fun.(*ast.FuncLit)
+	if !ok {
+		return false
+	}
+	found := false
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			if id, ok := call.Fun.(*ast.Ident); ok && id.Name == "recover" {
+				found = true
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// contextDoneOrErrTarget recognizes `ctx.Done()`/`ctx.Err()` called as
+// the operand of a receive (`<-ctx.Done()`), returning the context
+// variable's name.
+func contextDoneOrErrTarget(e ast.Expr) (string, bool) {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Done" && sel.Sel.Name != "Err") {
+		return "", false
+	}
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	return id.Name, true
+}
+
+// contextParamNames returns the names of fn's context.Context parameters.
+func contextParamNames(fn *ast.FuncDecl) map[string]bool {
+	names := map[string]bool{}
+	if fn.Type.Params == nil {
+		return names
+	}
+	for _, field := range fn.Type.Params.List {
+		sel, ok := field.Type.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Context" {
+			continue
+		}
+		if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "context" {
+			continue
+		}
+		for _, name := range field.Names {
+			names[name.Name] = true
+		}
+	}
+	return names
+}
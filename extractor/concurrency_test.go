@@ -0,0 +1,109 @@
+package extractor
+
+import "testing"
+
+func concurrencyNode(nodes []Node, funcName string) *Node {
+	for i := range nodes {
+		if nodes[i].Kind == NodeConcurrency && nodes[i].Attrs["func"] == funcName {
+			return &nodes[i]
+		}
+	}
+	return nil
+}
+
+func TestExtractConcurrency_ProcessUsersAsync(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	nodes, edges := ExtractConcurrency(fset, file)
+
+	n := concurrencyNode(nodes, "ProcessUsersAsync")
+	if n == nil {
+		t.Fatalf("expected a Concurrency node for ProcessUsersAsync, got %+v", nodes)
+	}
+	if n.Attrs["goroutine_count"] != 1 {
+		t.Errorf("goroutine_count = %v, want 1", n.Attrs["goroutine_count"])
+	}
+	chans, _ := n.Attrs["channels"].([]ChannelInfo)
+	if len(chans) != 1 || chans[0].Name != "ch" || chans[0].ElemType != "*User" || chans[0].Buffer != "BufferSize" {
+		t.Errorf("channels = %+v, want one ch:*User buffered BufferSize", chans)
+	}
+	ctxParams, _ := n.Attrs["context_params"].([]string)
+	if len(ctxParams) != 1 || ctxParams[0] != "ctx" {
+		t.Errorf("context_params = %v, want [ctx]", ctxParams)
+	}
+
+	var spawned string
+	for _, e := range edges {
+		if e.Kind == EdgeSpawns && e.From == "ProcessUsersAsync" {
+			spawned = e.To
+		}
+	}
+	if spawned == "" {
+		t.Fatalf("expected a spawns edge from ProcessUsersAsync")
+	}
+
+	var sends, closes bool
+	for _, e := range edges {
+		if e.From != spawned {
+			continue
+		}
+		if e.Kind == EdgeSendsOn && e.To == "ch" {
+			sends = true
+		}
+		if e.Kind == EdgeCloses && e.To == "ch" {
+			closes = true
+		}
+	}
+	if !sends {
+		t.Errorf("expected the spawned goroutine to send on ch")
+	}
+	if !closes {
+		t.Errorf("expected the spawned goroutine to close ch")
+	}
+}
+
+func TestExtractConcurrency_BulkOperationRecover(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	nodes, _ := ExtractConcurrency(fset, file)
+
+	n := concurrencyNode(nodes, "BulkOperation")
+	if n == nil {
+		t.Fatalf("expected a Concurrency node for BulkOperation, got %+v", nodes)
+	}
+	if n.Attrs["has_recover"] != true {
+		t.Errorf("has_recover = %v, want true", n.Attrs["has_recover"])
+	}
+	mutexes, _ := n.Attrs["mutexes"].([]MutexUse)
+	if len(mutexes) != 2 || mutexes[0].Op != "RLock" || mutexes[1].Op != "RUnlock" {
+		t.Errorf("mutexes = %+v, want [RLock RUnlock] on mutex", mutexes)
+	}
+}
+
+func TestExtractConcurrency_ProcessUsersBatchWaitGroupAndChannels(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	nodes, _ := ExtractConcurrency(fset, file)
+
+	n := concurrencyNode(nodes, "ProcessUsersBatch")
+	if n == nil {
+		t.Fatalf("expected a Concurrency node for ProcessUsersBatch, got %+v", nodes)
+	}
+	if n.Attrs["goroutine_count"] != 1 {
+		t.Errorf("goroutine_count = %v, want 1 (one `go func(workerID int)` launch site)", n.Attrs["goroutine_count"])
+	}
+	chans, _ := n.Attrs["channels"].([]ChannelInfo)
+	names := map[string]bool{}
+	for _, c := range chans {
+		names[c.Name] = true
+	}
+	if !names["jobs"] || !names["results"] {
+		t.Errorf("channels = %+v, want jobs and results", chans)
+	}
+}
+
+func TestExtractConcurrency_SkipsFunctionsWithNoConcurrency(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	nodes, _ := ExtractConcurrency(fset, file)
+
+	if concurrencyNode(nodes, "generateID") != nil {
+		t.Errorf("generateID has no concurrency constructs, should not get a Concurrency node")
+	}
+}
@@ -0,0 +1,46 @@
+// Package extractor implements Go-specific analysis passes over parsed
+// source files, producing the node/edge shapes the code graph expects.
+// Each pass takes a *ast.File (plus a *token.FileSet for positions) and
+// returns Nodes and Edges that a caller merges into the wider graph.
+package extractor
+
+// NodeKind identifies what a Node represents in the graph.
+type NodeKind string
+
+const (
+	NodeTypeParam              NodeKind = "TypeParam"
+	NodeConcurrency            NodeKind = "Concurrency"
+	NodeNearSatisfiesInterface NodeKind = "NearSatisfiesInterface"
+	NodeField                  NodeKind = "Field"
+)
+
+// EdgeKind identifies the relationship an Edge encodes.
+type EdgeKind string
+
+const (
+	EdgeBoundTo      EdgeKind = "bound_to"
+	EdgeInstantiates EdgeKind = "instantiates"
+	EdgeImplements   EdgeKind = "implements"
+	EdgeSpawns       EdgeKind = "spawns"
+	EdgeSendsOn      EdgeKind = "sends_on"
+	EdgeReceivesOn   EdgeKind = "receives_on"
+	EdgeCloses       EdgeKind = "closes"
+	EdgeTagIndex     EdgeKind = "tag_index"
+)
+
+// Node is a graph node produced by an analysis pass. Attrs carries
+// pass-specific data (e.g. a TypeParam's constraint, a Concurrency node's
+// channel list) so callers don't need a dedicated struct per kind.
+type Node struct {
+	ID    string
+	Kind  NodeKind
+	Attrs map[string]any
+}
+
+// Edge connects two nodes (addressed by ID) with pass-specific data.
+type Edge struct {
+	From  string
+	To    string
+	Kind  EdgeKind
+	Attrs map[string]any
+}
@@ -0,0 +1,294 @@
+package extractor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// methodSet maps a method name to its canonical signature string.
+type methodSet map[string]string
+
+// structInfo is what we need per concrete struct to compute its method
+// sets: its own declared methods (split by receiver kind) and the names
+// of any embedded (anonymous) fields, so promoted methods can be
+// resolved.
+type structInfo struct {
+	name     string
+	valueOwn methodSet
+	ptrOwn   methodSet
+	embeds   []embeddedField
+}
+
+// embeddedField is one anonymous field on a struct, e.g. `BaseService`
+// (pointer=false) or `*BaseService` (pointer=true). The two promote
+// differently: a pointer embed contributes its full method set (value
+// and pointer receivers alike) to both the outer value and pointer
+// types, where a value embed only promotes its value-receiver methods
+// to the outer value type.
+type embeddedField struct {
+	name    string
+	pointer bool
+}
+
+// ExtractImplements computes, for every concrete struct type declared
+// across files, its value- and pointer-receiver method sets (including
+// methods promoted from embedded structs), then emits an `implements`
+// edge from each type to every interface whose required method set is a
+// subset. Where a type is missing exactly one required method, it emits
+// a NearSatisfiesInterface diagnostic node instead.
+func ExtractImplements(fset *token.FileSet, files ...*ast.File) ([]Node, []Edge) {
+	structs, ifaces := collectTypesAndMethods(files)
+
+	var nodes []Node
+	var edges []Edge
+
+	for _, si := range structs {
+		valueSet, ptrSet := resolveMethodSets(si, structs, map[string]bool{})
+
+		for ifaceName, iface := range ifaces {
+			required := interfaceMethodSet(iface, ifaces, map[string]bool{})
+			if len(required) == 0 {
+				continue
+			}
+			missingValue := missing(required, valueSet)
+			missingPtr := missing(required, ptrSet)
+
+			if len(missingValue) == 0 {
+				edges = append(edges, Edge{From: si.name, To: ifaceName, Kind: EdgeImplements})
+			} else if len(missingValue) == 1 {
+				nodes = append(nodes, nearSatisfiesNode(si.name, ifaceName, missingValue[0]))
+			}
+
+			if len(missingPtr) == 0 {
+				edges = append(edges, Edge{From: "*" + si.name, To: ifaceName, Kind: EdgeImplements})
+			} else if len(missingPtr) == 1 {
+				nodes = append(nodes, nearSatisfiesNode("*"+si.name, ifaceName, missingPtr[0]))
+			}
+		}
+	}
+
+	return nodes, edges
+}
+
+// collectTypesAndMethods walks files once to gather every struct's own
+// declared methods (and embedded field names) plus every interface's
+// declared method set, keyed by type name.
+func collectTypesAndMethods(files []*ast.File) (map[string]*structInfo, map[string]*ast.InterfaceType) {
+	structs := map[string]*structInfo{}
+	ifaces := map[string]*ast.InterfaceType{}
+
+	getStruct := func(name string) *structInfo {
+		si := structs[name]
+		if si == nil {
+			si = &structInfo{name: name, valueOwn: methodSet{}, ptrOwn: methodSet{}}
+			structs[name] = si
+		}
+		return si
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				switch t := ts.Type.(type) {
+				case *ast.StructType:
+					si := getStruct(ts.Name.Name)
+					for _, field := range t.Fields.List {
+						if len(field.Names) != 0 {
+							continue
+						}
+						fieldType := field.Type
+						pointer := false
+						if star, ok := fieldType.(*ast.StarExpr); ok {
+							fieldType = star.X
+							pointer = true
+						}
+						si.embeds = append(si.embeds, embeddedField{name: exprString(fieldType), pointer: pointer})
+					}
+				case *ast.InterfaceType:
+					ifaces[ts.Name.Name] = t
+				}
+			}
+		}
+	}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+				continue
+			}
+			recvType := fn.Recv.List[0].Type
+			pointer := false
+			if star, ok := recvType.(*ast.StarExpr); ok {
+				recvType = star.X
+				pointer = true
+			}
+			// A single-parameter receiver (Cache[T]) is recorded under its
+			// base type name, with T left as written in its signatures;
+			// multi-parameter receivers (Pair[K, V]) are skipped.
+			if idx, ok := recvType.(*ast.IndexExpr); ok {
+				recvType = idx.X
+			}
+			ident, ok := recvType.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			si := getStruct(ident.Name)
+			sig := funcTypeSig(fn.Type)
+			if pointer {
+				si.ptrOwn[fn.Name.Name] = sig
+			} else {
+				si.valueOwn[fn.Name.Name] = sig
+			}
+		}
+	}
+
+	return structs, ifaces
+}
+
+func nearSatisfiesNode(typeName, ifaceName, missingMethod string) Node {
+	id := fmt.Sprintf("%s~%s", typeName, ifaceName)
+	return Node{
+		ID:   id,
+		Kind: NodeNearSatisfiesInterface,
+		Attrs: map[string]any{
+			"type":           typeName,
+			"interface":      ifaceName,
+			"missing_method": missingMethod,
+		},
+	}
+}
+
+// resolveMethodSets returns (valueMethodSet, pointerMethodSet) for si,
+// folding in methods promoted from embedded structs. Go's promotion
+// rule: a value embed (`Base`) promotes its value-receiver methods to
+// the outer value type, and both its value- and pointer-receiver
+// methods to the outer pointer type; a pointer embed (`*Base`) promotes
+// its full method set (value and pointer receivers alike) to both the
+// outer value and pointer types.
+func resolveMethodSets(si *structInfo, all map[string]*structInfo, seen map[string]bool) (methodSet, methodSet) {
+	value := methodSet{}
+	ptr := methodSet{}
+	for k, v := range si.valueOwn {
+		value[k] = v
+		ptr[k] = v
+	}
+	for k, v := range si.ptrOwn {
+		ptr[k] = v
+	}
+
+	if seen[si.name] {
+		return value, ptr
+	}
+	seen[si.name] = true
+
+	for _, embedded := range si.embeds {
+		embSi := all[embedded.name]
+		if embSi == nil {
+			continue
+		}
+		embValue, embPtr := resolveMethodSets(embSi, all, seen)
+		for k, v := range embValue {
+			if _, exists := value[k]; !exists {
+				value[k] = v
+			}
+			if _, exists := ptr[k]; !exists {
+				ptr[k] = v
+			}
+		}
+		for k, v := range embPtr {
+			if _, exists := ptr[k]; !exists {
+				ptr[k] = v
+			}
+			if embedded.pointer {
+				if _, exists := value[k]; !exists {
+					value[k] = v
+				}
+			}
+		}
+	}
+	return value, ptr
+}
+
+// interfaceMethodSet resolves an interface's full required method set,
+// including methods promoted from embedded interfaces (e.g.
+// UserRepository embedding Repository).
+func interfaceMethodSet(iface *ast.InterfaceType, all map[string]*ast.InterfaceType, seen map[string]bool) methodSet {
+	required := methodSet{}
+	if iface.Methods == nil {
+		return required
+	}
+	for _, field := range iface.Methods.List {
+		if len(field.Names) > 0 {
+			ft, ok := field.Type.(*ast.FuncType)
+			if !ok {
+				continue
+			}
+			required[field.Names[0].Name] = funcTypeSig(ft)
+			continue
+		}
+		embeddedName := exprString(field.Type)
+		if seen[embeddedName] {
+			continue
+		}
+		seen[embeddedName] = true
+		if embIface, ok := all[embeddedName]; ok {
+			for k, v := range interfaceMethodSet(embIface, all, seen) {
+				required[k] = v
+			}
+		}
+	}
+	return required
+}
+
+func missing(required, have methodSet) []string {
+	var out []string
+	for name, sig := range required {
+		if haveSig, ok := have[name]; !ok || haveSig != sig {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// funcTypeSig renders a func type's parameter and result types into a
+// signature string independent of parameter names, e.g.
+// "(string)(interface{},error)" for Find(id string) (interface{}, error).
+func funcTypeSig(ft *ast.FuncType) string {
+	var params, results []string
+	if ft.Params != nil {
+		for _, f := range ft.Params.List {
+			t := exprString(f.Type)
+			n := len(f.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				params = append(params, t)
+			}
+		}
+	}
+	if ft.Results != nil {
+		for _, f := range ft.Results.List {
+			t := exprString(f.Type)
+			n := len(f.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				results = append(results, t)
+			}
+		}
+	}
+	return fmt.Sprintf("(%s)(%s)", strings.Join(params, ","), strings.Join(results, ","))
+}
@@ -0,0 +1,145 @@
+package extractor
+
+import (
+	"go/ast"
+	"testing"
+)
+
+func hasImplements(edges []Edge, from, to string) bool {
+	for _, e := range edges {
+		if e.Kind == EdgeImplements && e.From == from && e.To == to {
+			return true
+		}
+	}
+	return false
+}
+
+func TestExtractImplements_PointerReceiverMethods(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	_, edges := ExtractImplements(fset, file)
+
+	// GetCacheKey and Serialize are both declared on *User, so only the
+	// pointer type satisfies Cacheable, not the value type.
+	if !hasImplements(edges, "*User", "Cacheable") {
+		t.Errorf("expected *User to implement Cacheable, edges: %+v", edges)
+	}
+	if hasImplements(edges, "User", "Cacheable") {
+		t.Errorf("did not expect value User to implement Cacheable (methods are pointer-receiver)")
+	}
+}
+
+func TestExtractImplements_EmbeddedInterfaceRequirement(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	_, edges := ExtractImplements(fset, file)
+
+	// mockRepository's methods are all pointer-receiver, and
+	// UserRepository embeds Repository, so *mockRepository must satisfy
+	// both.
+	if !hasImplements(edges, "*mockRepository", "Repository") {
+		t.Errorf("expected *mockRepository to implement Repository, edges: %+v", edges)
+	}
+	if !hasImplements(edges, "*mockRepository", "UserRepository") {
+		t.Errorf("expected *mockRepository to implement UserRepository (via embedded Repository), edges: %+v", edges)
+	}
+}
+
+func TestExtractImplements_ValueVsPointerReceiverMix(t *testing.T) {
+	fset, file := parseFixture(t, "../test_go.go")
+	_, edges := ExtractImplements(fset, file)
+
+	// UserImpl.GetName/GetEmail use a *UserImpl receiver, IsActive uses a
+	// value receiver. Only *UserImpl's method set is a superset of User;
+	// the value UserImpl is missing GetName and GetEmail.
+	if !hasImplements(edges, "*UserImpl", "User") {
+		t.Errorf("expected *UserImpl to implement User, edges: %+v", edges)
+	}
+	if hasImplements(edges, "UserImpl", "User") {
+		t.Errorf("did not expect value UserImpl to implement User (GetName/GetEmail are pointer-receiver)")
+	}
+}
+
+// None of the checked-in fixtures has a type missing exactly one
+// interface method (UserImpl's value type is missing two from User), so
+// this exercises the diagnostic against a minimal inline source.
+func TestExtractImplements_NearSatisfiesDiagnostic(t *testing.T) {
+	const src = `package nearmiss
+
+type Cacheable interface {
+	GetCacheKey() string
+	Serialize() ([]byte, error)
+}
+
+type almostCacheable struct{}
+
+func (a almostCacheable) GetCacheKey() string { return "" }
+`
+	fset, file := parseSource(t, src)
+	nodes, edges := ExtractImplements(fset, file)
+
+	if hasImplements(edges, "almostCacheable", "Cacheable") {
+		t.Fatalf("almostCacheable is missing Serialize, should not implement Cacheable")
+	}
+
+	var found bool
+	for _, n := range nodes {
+		if n.Kind == NodeNearSatisfiesInterface && n.Attrs["type"] == "almostCacheable" && n.Attrs["interface"] == "Cacheable" {
+			found = true
+			if n.Attrs["missing_method"] != "Serialize" {
+				t.Errorf("missing_method = %v, want Serialize", n.Attrs["missing_method"])
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("expected a NearSatisfiesInterface node for almostCacheable vs Cacheable, got %+v", nodes)
+	}
+}
+
+func TestExtractImplements_PromotedMethodFromEmbedding(t *testing.T) {
+	_, file := parseFixture(t, "../fixtures/go/example.go")
+	structs, _ := collectTypesAndMethods([]*ast.File{file})
+
+	us := structs["UserService"]
+	if us == nil {
+		t.Fatalf("expected a UserService struct entry")
+	}
+	valueSet, ptrSet := resolveMethodSets(us, structs, map[string]bool{})
+
+	// Log is declared on *BaseService, and BaseService is embedded by
+	// value in UserService, so Log promotes to *UserService only.
+	if _, ok := valueSet["Log"]; ok {
+		t.Errorf("did not expect Log promoted to value UserService (BaseService.Log has a pointer receiver)")
+	}
+	if _, ok := ptrSet["Log"]; !ok {
+		t.Errorf("expected Log promoted to *UserService via embedded BaseService")
+	}
+}
+
+func TestExtractImplements_PointerEmbedPromotesToValueType(t *testing.T) {
+	const src = `package ptrembed
+
+type Base struct{}
+
+func (b *Base) Foo() {}
+
+type Derived struct {
+	*Base
+}
+`
+	_, file := parseSource(t, src)
+	structs, _ := collectTypesAndMethods([]*ast.File{file})
+
+	derived := structs["Derived"]
+	if derived == nil {
+		t.Fatalf("expected a Derived struct entry")
+	}
+	valueSet, ptrSet := resolveMethodSets(derived, structs, map[string]bool{})
+
+	// A pointer embed (*Base) promotes its whole method set, including
+	// pointer-receiver methods, to both Derived and *Derived.
+	if _, ok := valueSet["Foo"]; !ok {
+		t.Errorf("expected Foo promoted to value Derived via the embedded *Base")
+	}
+	if _, ok := ptrSet["Foo"]; !ok {
+		t.Errorf("expected Foo promoted to *Derived via the embedded *Base")
+	}
+}
@@ -0,0 +1,149 @@
+package extractor
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+)
+
+// TagValue is one key's parsed value out of a struct field's raw tag
+// string, e.g. `json:"metadata,omitempty"` parses to
+// {Value: "metadata", Options: ["omitempty"]}.
+type TagValue struct {
+	Value   string
+	Options []string
+}
+
+// HasOption reports whether opt was set for this tag value, e.g.
+// HasOption("omitempty").
+func (t TagValue) HasOption(opt string) bool {
+	for _, o := range t.Options {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// ExtractStructTags walks file and, for every struct field carrying a
+// tag, parses it into a key -> TagValue map (schema-agnostic: whatever
+// keys show up, be it json/db/yaml/validate/..., not a fixed allow-list)
+// and emits a Field node plus one tag_index edge per key so a query like
+// "which structs expose a db:\"email\" column" is a single edge lookup
+// keyed on "db:email" rather than a re-parse of every tag in the graph.
+func ExtractStructTags(file *ast.File) ([]Node, []Edge) {
+	var nodes []Node
+	var edges []Edge
+
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range st.Fields.List {
+				if field.Tag == nil || len(field.Names) == 0 {
+					continue
+				}
+				raw, err := strconv.Unquote(field.Tag.Value)
+				if err != nil {
+					continue
+				}
+				tags := parseStructTag(raw)
+				if len(tags) == 0 {
+					continue
+				}
+				for _, name := range field.Names {
+					fieldID := fmt.Sprintf("%s.%s", ts.Name.Name, name.Name)
+					nodes = append(nodes, Node{
+						ID:   fieldID,
+						Kind: NodeField,
+						Attrs: map[string]any{
+							"struct": ts.Name.Name,
+							"field":  name.Name,
+							"tags":   tags,
+						},
+					})
+					for key, tv := range tags {
+						edges = append(edges, Edge{
+							From: fieldID,
+							To:   fmt.Sprintf("%s:%s", key, tv.Value),
+							Kind: EdgeTagIndex,
+							Attrs: map[string]any{
+								"key":        key,
+								"value":      tv.Value,
+								"skip":       tv.Value == "-",
+								"omit_empty": tv.HasOption("omitempty"),
+								"as_string":  tv.HasOption("string"),
+							},
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return nodes, edges
+}
+
+// parseStructTag parses a raw struct tag into key -> TagValue, following
+// the grammar documented on reflect.StructTag: space-separated
+// key:"value" pairs, each value optionally comma-separated into a
+// primary value and trailing options (e.g. json:"name,omitempty").
+// Unlike reflect.StructTag.Get, this doesn't require knowing the key set
+// up front, so any tag format (json, db, yaml, validate, protobuf, ...)
+// is indexed the same way.
+func parseStructTag(tag string) map[string]TagValue {
+	tags := map[string]TagValue{}
+	for tag != "" {
+		// Skip leading space, mirroring reflect.StructTag.Lookup.
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		tag = tag[i:]
+		if tag == "" {
+			break
+		}
+
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			break
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			break
+		}
+		quoted := tag[:i+1]
+		tag = tag[i+1:]
+
+		value, err := strconv.Unquote(quoted)
+		if err != nil {
+			continue
+		}
+		parts := strings.Split(value, ",")
+		tags[key] = TagValue{Value: parts[0], Options: parts[1:]}
+	}
+	return tags
+}
@@ -0,0 +1,75 @@
+package extractor
+
+import "testing"
+
+func TestExtractStructTags_JSONAndDBColumns(t *testing.T) {
+	_, file := parseFixture(t, "../fixtures/go/example.go")
+	_, edges := ExtractStructTags(file)
+
+	var foundDBEmail, foundJSONName bool
+	for _, e := range edges {
+		if e.Kind != EdgeTagIndex {
+			continue
+		}
+		if e.From == "User.Email" && e.To == "db:email" {
+			foundDBEmail = true
+		}
+		if e.From == "User.Name" && e.To == "json:name" {
+			foundJSONName = true
+		}
+	}
+	if !foundDBEmail {
+		t.Errorf("expected a tag_index edge from User.Email to db:email, edges: %+v", edges)
+	}
+	if !foundJSONName {
+		t.Errorf("expected a tag_index edge from User.Name to json:name, edges: %+v", edges)
+	}
+}
+
+func TestExtractStructTags_OmitemptyOption(t *testing.T) {
+	_, file := parseFixture(t, "../fixtures/go/example.go")
+	_, edges := ExtractStructTags(file)
+
+	var found bool
+	for _, e := range edges {
+		if e.Kind == EdgeTagIndex && e.From == "User.Metadata" && e.To == "json:metadata" {
+			found = true
+			if e.Attrs["omit_empty"] != true {
+				t.Errorf("omit_empty = %v, want true for Metadata's json tag", e.Attrs["omit_empty"])
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("expected a tag_index edge from User.Metadata to json:metadata, edges: %+v", edges)
+	}
+}
+
+func TestExtractStructTags_FieldNodeCarriesAllKeys(t *testing.T) {
+	_, file := parseFixture(t, "../fixtures/go/example.go")
+	nodes, _ := ExtractStructTags(file)
+
+	var idField *Node
+	for i := range nodes {
+		if nodes[i].ID == "User.ID" {
+			idField = &nodes[i]
+		}
+	}
+	if idField == nil {
+		t.Fatalf("expected a Field node for User.ID")
+	}
+	tags, _ := idField.Attrs["tags"].(map[string]TagValue)
+	if tags["json"].Value != "id" || tags["db"].Value != "id" {
+		t.Errorf("tags = %+v, want json:id and db:id", tags)
+	}
+}
+
+func TestParseStructTag_SkipOptionAndMultipleOptions(t *testing.T) {
+	tags := parseStructTag(`json:"-" validate:"required,min=3,max=10"`)
+	if tags["json"].Value != "-" {
+		t.Errorf(`json value = %q, want "-"`, tags["json"].Value)
+	}
+	v := tags["validate"]
+	if v.Value != "required" || len(v.Options) != 2 || v.Options[0] != "min=3" || v.Options[1] != "max=10" {
+		t.Errorf("validate tag = %+v, want Value=required Options=[min=3 max=10]", v)
+	}
+}
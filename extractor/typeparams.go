@@ -0,0 +1,288 @@
+package extractor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+// genericDecl tracks a declared generic type or function and the names
+// of its type parameters (each TypeParam node's ID is declID + "." +
+// name), so instantiation sites and parameterized methods can be linked
+// back to the right params.
+type genericDecl struct {
+	declID     string
+	paramNames []string // e.g. ["T"] for Cache[T any]
+}
+
+// ExtractTypeParams walks file and produces:
+//   - a TypeParam node per declared type parameter, bound_to its owning
+//     type or func decl (e.g. Cache[T any] -> TypeParam{T, any} bound_to "Cache")
+//   - an instantiates edge from each use/call site to the generic decl,
+//     carrying the concrete type arguments (e.g. NewCache[string](...) ->
+//     instantiates "NewCache" with type_args=["string"])
+//   - for methods on a parameterized receiver (func (c *Cache[T]) Set),
+//     a bound_to edge from the method to both the receiver's type decl
+//     and its TypeParam, so a method-set query on a concrete
+//     instantiation can substitute T for the concrete type argument.
+func ExtractTypeParams(fset *token.FileSet, file *ast.File) ([]Node, []Edge) {
+	var nodes []Node
+	var edges []Edge
+
+	// declaredTypeParams maps a generic type/func name to its declared
+	// parameter names, so receiver and instantiation lookups can find it.
+	declaredTypeParams := map[string]genericDecl{}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok || ts.TypeParams == nil {
+					continue
+				}
+				gd := genericDecl{declID: ts.Name.Name}
+				for _, field := range ts.TypeParams.List {
+					constraint := exprString(field.Type)
+					for _, name := range field.Names {
+						nodeID := fmt.Sprintf("%s.%s", ts.Name.Name, name.Name)
+						nodes = append(nodes, Node{
+							ID:   nodeID,
+							Kind: NodeTypeParam,
+							Attrs: map[string]any{
+								"name":       name.Name,
+								"constraint": constraint,
+							},
+						})
+						edges = append(edges, Edge{From: nodeID, To: ts.Name.Name, Kind: EdgeBoundTo})
+						gd.paramNames = append(gd.paramNames, name.Name)
+					}
+				}
+				declaredTypeParams[ts.Name.Name] = gd
+			}
+
+		case *ast.FuncDecl:
+			if d.Type.TypeParams == nil {
+				continue
+			}
+			gd := genericDecl{declID: d.Name.Name}
+			for _, field := range d.Type.TypeParams.List {
+				constraint := exprString(field.Type)
+				for _, name := range field.Names {
+					nodeID := fmt.Sprintf("%s.%s", d.Name.Name, name.Name)
+					nodes = append(nodes, Node{
+						ID:   nodeID,
+						Kind: NodeTypeParam,
+						Attrs: map[string]any{
+							"name":       name.Name,
+							"constraint": constraint,
+						},
+					})
+					edges = append(edges, Edge{From: nodeID, To: d.Name.Name, Kind: EdgeBoundTo})
+					gd.paramNames = append(gd.paramNames, name.Name)
+				}
+			}
+			declaredTypeParams[d.Name.Name] = gd
+		}
+	}
+
+	// Methods on a parameterized receiver link back to both the
+	// receiver's type decl and its TypeParam nodes.
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		recvName, ok := parameterizedReceiverIdent(fn)
+		if !ok {
+			continue
+		}
+		gd, known := declaredTypeParams[recvName.Name]
+		if !known {
+			continue
+		}
+		methodID := fn.Name.Name
+		edges = append(edges, Edge{From: methodID, To: gd.declID, Kind: EdgeBoundTo,
+			Attrs: map[string]any{"via": "receiver"}})
+		for _, p := range gd.paramNames {
+			edges = append(edges, Edge{From: methodID, To: fmt.Sprintf("%s.%s", gd.declID, p), Kind: EdgeBoundTo,
+				Attrs: map[string]any{"via": "receiver"}})
+		}
+	}
+
+	// Instantiation sites: any IndexExpr/IndexListExpr whose base
+	// identifier names a known generic decl, and whose type argument(s)
+	// are concrete types rather than one of the enclosing func/type's own
+	// in-scope type parameters. `(c *Cache[T]) Set`'s receiver, `*Cache[T]`
+	// in NewCache's own signature, and `Cache[T]{...}` in NewCache's body
+	// all use T as itself — forwarding the caller's still-unknown type
+	// argument, not substituting a concrete type — so none of those are
+	// real instantiation sites, whether or not the generic being used is
+	// the same decl that declared T.
+	var walk func(n ast.Node, enclosing string, enclosingParams map[string]bool)
+	walk = func(n ast.Node, enclosing string, enclosingParams map[string]bool) {
+		if n == nil {
+			return
+		}
+		switch v := n.(type) {
+		case *ast.FuncDecl:
+			enclosing = v.Name.Name
+			enclosingParams = typeParamNameSet(declaredTypeParams[enclosing])
+			// A method on a parameterized receiver (func (c *Cache[T])
+			// Set) sees T as its own type parameter too, even though
+			// Set itself has no `[T any]` of its own.
+			if recvParams := receiverTypeParamNames(v, declaredTypeParams); len(recvParams) > 0 {
+				if enclosingParams == nil {
+					enclosingParams = map[string]bool{}
+				}
+				for name := range recvParams {
+					enclosingParams[name] = true
+				}
+			}
+		case *ast.TypeSpec:
+			enclosing = v.Name.Name
+			enclosingParams = typeParamNameSet(declaredTypeParams[enclosing])
+		case *ast.IndexExpr:
+			if ident, ok := v.X.(*ast.Ident); ok {
+				if _, known := declaredTypeParams[ident.Name]; known && !isSelfReference(v.Index, enclosingParams) {
+					edges = append(edges, Edge{
+						From: siteID(fset, v), To: ident.Name, Kind: EdgeInstantiates,
+						Attrs: map[string]any{"type_args": []string{exprString(v.Index)}, "from_decl": enclosing},
+					})
+				}
+			}
+		case *ast.IndexListExpr:
+			if ident, ok := v.X.(*ast.Ident); ok {
+				if _, known := declaredTypeParams[ident.Name]; known && !allSelfReferences(v.Indices, enclosingParams) {
+					args := make([]string, len(v.Indices))
+					for i, idx := range v.Indices {
+						args[i] = exprString(idx)
+					}
+					edges = append(edges, Edge{
+						From: siteID(fset, v), To: ident.Name, Kind: EdgeInstantiates,
+						Attrs: map[string]any{"type_args": args, "from_decl": enclosing},
+					})
+				}
+			}
+		}
+		ast.Inspect(n, func(child ast.Node) bool {
+			if child == n || child == nil {
+				return true
+			}
+			walk(child, enclosing, enclosingParams)
+			return false
+		})
+	}
+	for _, decl := range file.Decls {
+		walk(decl, "", nil)
+	}
+
+	return nodes, edges
+}
+
+// parameterizedReceiverIdent returns the base type identifier of fn's
+// receiver if fn is a method on a parameterized receiver
+// (func (c *Cache[T]) Set returns "Cache"), unwrapping the leading
+// pointer star first.
+func parameterizedReceiverIdent(fn *ast.FuncDecl) (*ast.Ident, bool) {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return nil, false
+	}
+	recvType := fn.Recv.List[0].Type
+	if star, ok := recvType.(*ast.StarExpr); ok {
+		recvType = star.X
+	}
+	idx, ok := recvType.(*ast.IndexExpr)
+	if !ok {
+		return nil, false
+	}
+	recvName, ok := idx.X.(*ast.Ident)
+	return recvName, ok
+}
+
+// receiverTypeParamNames returns the type-parameter names declared on
+// fn's receiver type, if fn is a method on a parameterized receiver
+// (func (c *Cache[T]) Set), so T reads as self-referential inside Set's
+// signature and body, not as an instantiation of Cache.
+func receiverTypeParamNames(fn *ast.FuncDecl, declaredTypeParams map[string]genericDecl) map[string]bool {
+	recvName, ok := parameterizedReceiverIdent(fn)
+	if !ok {
+		return nil
+	}
+	return typeParamNameSet(declaredTypeParams[recvName.Name])
+}
+
+// typeParamNameSet returns gd's own declared type-parameter names as a
+// set, for recognizing self-referential uses like `Cache[T]` inside
+// Cache's own method/func bodies.
+func typeParamNameSet(gd genericDecl) map[string]bool {
+	if len(gd.paramNames) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(gd.paramNames))
+	for _, p := range gd.paramNames {
+		set[p] = true
+	}
+	return set
+}
+
+// isSelfReference reports whether idx is a bare identifier naming one of
+// the enclosing func/type's own type parameters (e.g. T inside Cache[T]'s
+// own methods, or NewCache's own T forwarded into Cache[T]), as opposed
+// to a concrete type argument.
+func isSelfReference(idx ast.Expr, enclosingParams map[string]bool) bool {
+	ident, ok := idx.(*ast.Ident)
+	return ok && enclosingParams[ident.Name]
+}
+
+// allSelfReferences reports whether every index in a multi-param
+// instantiation (Pair[K, V]) is one of the enclosing func/type's own
+// type parameters.
+func allSelfReferences(indices []ast.Expr, enclosingParams map[string]bool) bool {
+	if len(indices) == 0 {
+		return false
+	}
+	for _, idx := range indices {
+		if !isSelfReference(idx, enclosingParams) {
+			return false
+		}
+	}
+	return true
+}
+
+func siteID(fset *token.FileSet, n ast.Node) string {
+	pos := fset.Position(n.Pos())
+	return fmt.Sprintf("%s:%d:%d", pos.Filename, pos.Line, pos.Column)
+}
+
+// exprString renders an ast.Expr back to its source text for simple
+// expressions (identifiers, selectors, pointers) without needing a
+// full-blown printer.
+func exprString(e ast.Expr) string {
+	switch v := e.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.StarExpr:
+		return "*" + exprString(v.X)
+	case *ast.SelectorExpr:
+		return exprString(v.X) + "." + v.Sel.Name
+	case *ast.IndexExpr:
+		return exprString(v.X) + "[" + exprString(v.Index) + "]"
+	case *ast.ArrayType:
+		return "[]" + exprString(v.Elt)
+	case *ast.MapType:
+		return "map[" + exprString(v.Key) + "]" + exprString(v.Value)
+	case *ast.Ellipsis:
+		return "..." + exprString(v.Elt)
+	case *ast.InterfaceType:
+		if v.Methods == nil || len(v.Methods.List) == 0 {
+			return "interface{}"
+		}
+		return "interface{...}"
+	default:
+		return fmt.Sprintf("%T", e)
+	}
+}
@@ -0,0 +1,144 @@
+package extractor
+
+import (
+	"testing"
+)
+
+func TestExtractTypeParams_CacheDecl(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	nodes, edges := ExtractTypeParams(fset, file)
+
+	var foundParam bool
+	for _, n := range nodes {
+		if n.ID == "Cache.T" && n.Kind == NodeTypeParam {
+			foundParam = true
+			if n.Attrs["constraint"] != "any" {
+				t.Errorf("Cache.T constraint = %v, want any", n.Attrs["constraint"])
+			}
+		}
+	}
+	if !foundParam {
+		t.Fatalf("expected a TypeParam node for Cache.T, got nodes %+v", nodes)
+	}
+
+	var boundToCache bool
+	for _, e := range edges {
+		if e.From == "Cache.T" && e.To == "Cache" && e.Kind == EdgeBoundTo {
+			boundToCache = true
+		}
+	}
+	if !boundToCache {
+		t.Fatalf("expected Cache.T bound_to Cache edge, got edges %+v", edges)
+	}
+}
+
+func TestExtractTypeParams_MethodLinkedToReceiver(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	_, edges := ExtractTypeParams(fset, file)
+
+	var linkedToType, linkedToParam bool
+	for _, e := range edges {
+		if e.From == "Set" && e.Kind == EdgeBoundTo {
+			if e.To == "Cache" {
+				linkedToType = true
+			}
+			if e.To == "Cache.T" {
+				linkedToParam = true
+			}
+		}
+	}
+	if !linkedToType || !linkedToParam {
+		t.Fatalf("expected Set to bind to both Cache and Cache.T, got edges %+v", edges)
+	}
+}
+
+func TestExtractTypeParams_InstantiationSites(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	_, edges := ExtractTypeParams(fset, file)
+
+	var fieldSite, callSite bool
+	for _, e := range edges {
+		if e.Kind != EdgeInstantiates {
+			continue
+		}
+		args, _ := e.Attrs["type_args"].([]string)
+		if len(args) != 1 || args[0] != "string" {
+			continue
+		}
+		switch {
+		case e.To == "Cache" && e.Attrs["from_decl"] == "BaseService":
+			fieldSite = true
+		case e.To == "NewCache" && e.Attrs["from_decl"] == "NewUserService":
+			callSite = true
+		}
+	}
+	if !fieldSite {
+		t.Errorf("expected an instantiates edge from BaseService's cache field to Cache[string]")
+	}
+	if !callSite {
+		t.Errorf("expected an instantiates edge from NewUserService's NewCache[string](...) call to NewCache")
+	}
+}
+
+// TestExtractTypeParams_SelfReferentialUseIsNotAnInstantiation guards
+// against counting `Cache[T]`/`NewCache[T]`/`(c *Cache[T])` inside
+// Cache's own declaration as "instantiated with T=T" — those are T used
+// as itself, not a concrete type substitution, and must not pollute the
+// "who instantiates Cache with what T?" query.
+func TestExtractTypeParams_SelfReferentialUseIsNotAnInstantiation(t *testing.T) {
+	fset, file := parseFixture(t, "../fixtures/go/example.go")
+	_, edges := ExtractTypeParams(fset, file)
+
+	for _, e := range edges {
+		if e.Kind != EdgeInstantiates {
+			continue
+		}
+		args, _ := e.Attrs["type_args"].([]string)
+		if len(args) == 1 && args[0] == "T" {
+			t.Errorf("unexpected self-referential instantiates edge: %+v", e)
+		}
+	}
+}
+
+// TestExtractTypeParams_ForwardingToADifferentGenericIsNotAnInstantiation
+// guards the same rule across decls, not just within one: Wrap forwards
+// its own still-unknown T into Box[T], which is exactly as uninformative
+// as NewCache forwarding its own T into Cache[T] — neither call site
+// tells us what concrete type Box/Cache ends up instantiated with, so
+// neither should produce an instantiates edge, regardless of Box and
+// Wrap being different decls that happen to both name their parameter T.
+func TestExtractTypeParams_ForwardingToADifferentGenericIsNotAnInstantiation(t *testing.T) {
+	const src = `package boxwrap
+
+type Box[T any] struct {
+	Val T
+}
+
+func Wrap[T any](v T) Box[T] {
+	return Box[T]{Val: v}
+}
+
+func WrapString() Box[string] {
+	return Box[string]{Val: "x"}
+}
+`
+	fset, file := parseSource(t, src)
+	_, edges := ExtractTypeParams(fset, file)
+
+	var sawConcrete bool
+	for _, e := range edges {
+		if e.Kind != EdgeInstantiates || e.To != "Box" {
+			continue
+		}
+		args, _ := e.Attrs["type_args"].([]string)
+		if len(args) == 1 && args[0] == "T" {
+			t.Errorf("unexpected forwarded-type-param instantiates edge from %v: %+v", e.Attrs["from_decl"], e)
+		}
+		if len(args) == 1 && args[0] == "string" {
+			sawConcrete = true
+		}
+	}
+	if !sawConcrete {
+		t.Fatalf("expected an instantiates edge from WrapString to Box with type_args=[string], got edges %+v", edges)
+	}
+}